@@ -0,0 +1,169 @@
+package reflect_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	fwreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+type aliasModel struct {
+	Name string `tfsdk:"name" tfsdk_aliases:"old_name,older_name"`
+}
+
+func aliasObjectType(attrName string) attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		attrName: types.StringType,
+	}}
+}
+
+func TestStruct_tfsdkAliasMatchesHistoricalName(t *testing.T) {
+	t.Parallel()
+
+	objType := aliasObjectType("old_name")
+	object := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"old_name": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"old_name": tftypes.NewValue(tftypes.String, "abc123"),
+	})
+
+	target := reflect.New(reflect.TypeOf(aliasModel{})).Elem()
+
+	result, diags := fwreflect.Struct(context.Background(), objType, object, target, fwreflect.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	got := result.Interface().(aliasModel)
+	if want := "abc123"; got.Name != want {
+		t.Errorf("Name: got %q, want %q", got.Name, want)
+	}
+}
+
+func TestStruct_tfsdkAliasCollision(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Name string `tfsdk:"name" tfsdk_aliases:"other"`
+		// Other's canonical name collides with Name's alias.
+		Other string `tfsdk:"other"`
+	}
+
+	objType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":  types.StringType,
+		"other": types.StringType,
+	}}
+	object := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":  tftypes.String,
+		"other": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"name":  tftypes.NewValue(tftypes.String, "a"),
+		"other": tftypes.NewValue(tftypes.String, "b"),
+	})
+
+	target := reflect.New(reflect.TypeOf(model{})).Elem()
+
+	_, diags := fwreflect.Struct(context.Background(), objType, object, target, fwreflect.Options{}, path.Empty())
+	if !diags.HasError() {
+		t.Fatalf("expected a collision diagnostic between the other field and the name field's alias, got none")
+	}
+}
+
+func TestStruct_allowExtraStructFields(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Name  string `tfsdk:"name"`
+		Extra string `tfsdk:"extra"`
+	}
+
+	objType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name": types.StringType,
+	}}
+	object := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "abc123"),
+	})
+
+	target := reflect.New(reflect.TypeOf(model{})).Elem()
+
+	result, diags := fwreflect.Struct(context.Background(), objType, object, target, fwreflect.Options{AllowExtraStructFields: true}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(diags) == 0 {
+		t.Errorf("expected a warning diagnostic about the unmatched extra struct field")
+	}
+
+	got := result.Interface().(model)
+	if got.Name != "abc123" {
+		t.Errorf("Name: got %q, want %q", got.Name, "abc123")
+	}
+	if got.Extra != "" {
+		t.Errorf("Extra: expected the unmatched field to be left at its zero value, got %q", got.Extra)
+	}
+}
+
+func TestStruct_allowExtraObjectAttributes(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Name string `tfsdk:"name"`
+	}
+
+	objType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":  types.StringType,
+		"extra": types.StringType,
+	}}
+	object := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":  tftypes.String,
+		"extra": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"name":  tftypes.NewValue(tftypes.String, "abc123"),
+		"extra": tftypes.NewValue(tftypes.String, "discarded"),
+	})
+
+	target := reflect.New(reflect.TypeOf(model{})).Elem()
+
+	result, diags := fwreflect.Struct(context.Background(), objType, object, target, fwreflect.Options{AllowExtraObjectAttributes: true}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	got := result.Interface().(model)
+	if got.Name != "abc123" {
+		t.Errorf("Name: got %q, want %q", got.Name, "abc123")
+	}
+}
+
+func TestStruct_extraFieldsWithoutAllowAreErrors(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Name  string `tfsdk:"name"`
+		Extra string `tfsdk:"extra"`
+	}
+
+	objType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name": types.StringType,
+	}}
+	object := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "abc123"),
+	})
+
+	target := reflect.New(reflect.TypeOf(model{})).Elem()
+
+	_, diags := fwreflect.Struct(context.Background(), objType, object, target, fwreflect.Options{}, path.Empty())
+	if !diags.HasError() {
+		t.Fatalf("expected an error when AllowExtraStructFields is not set and the struct defines an extra field")
+	}
+}
@@ -0,0 +1,115 @@
+package reflect_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	fwreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+type commonModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func TestStruct_embeddedStructPromotion(t *testing.T) {
+	t.Parallel()
+
+	type resourceModel struct {
+		commonModel
+		Name types.String `tfsdk:"name"`
+	}
+
+	objType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	}}
+	object := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":   tftypes.String,
+		"name": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "abc123"),
+		"name": tftypes.NewValue(tftypes.String, "my-resource"),
+	})
+
+	target := reflect.New(reflect.TypeOf(resourceModel{})).Elem()
+
+	result, diags := fwreflect.Struct(context.Background(), objType, object, target, fwreflect.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	got := result.Interface().(resourceModel)
+	if want := types.StringValue("abc123"); !got.ID.Equal(want) {
+		t.Errorf("ID: got %#v, want %#v", got.ID, want)
+	}
+	if want := types.StringValue("my-resource"); !got.Name.Equal(want) {
+		t.Errorf("Name: got %#v, want %#v", got.Name, want)
+	}
+}
+
+func TestStruct_embeddedPointerStructPromotion(t *testing.T) {
+	t.Parallel()
+
+	type resourceModel struct {
+		*commonModel
+		Name types.String `tfsdk:"name"`
+	}
+
+	objType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	}}
+	object := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":   tftypes.String,
+		"name": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "abc123"),
+		"name": tftypes.NewValue(tftypes.String, "my-resource"),
+	})
+
+	target := reflect.New(reflect.TypeOf(resourceModel{})).Elem()
+
+	result, diags := fwreflect.Struct(context.Background(), objType, object, target, fwreflect.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	got := result.Interface().(resourceModel)
+	if got.commonModel == nil {
+		t.Fatalf("expected the nil embedded *commonModel to be allocated")
+	}
+	if want := types.StringValue("abc123"); !got.ID.Equal(want) {
+		t.Errorf("ID: got %#v, want %#v", got.ID, want)
+	}
+}
+
+func TestStruct_embeddedFieldCollision(t *testing.T) {
+	t.Parallel()
+
+	type resourceModel struct {
+		commonModel
+		ID types.String `tfsdk:"id"`
+	}
+
+	objType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id": types.StringType,
+	}}
+	object := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "abc123"),
+	})
+
+	target := reflect.New(reflect.TypeOf(resourceModel{})).Elem()
+
+	_, diags := fwreflect.Struct(context.Background(), objType, object, target, fwreflect.Options{}, path.Empty())
+	if !diags.HasError() {
+		t.Fatalf("expected a collision diagnostic between the promoted and directly-declared id field, got none")
+	}
+}
@@ -0,0 +1,121 @@
+package reflect_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	fwreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+func toSnakeFieldNameConverter(goFieldName string) string {
+	runes := []rune(goFieldName)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && r >= 'A' && r <= 'Z' && runes[i-1] >= 'a' && runes[i-1] <= 'z' {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToLower(b.String())
+}
+
+func TestStruct_fieldNameConverter(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		UserID string
+	}
+
+	objType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"user_id": types.StringType,
+	}}
+	object := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"user_id": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"user_id": tftypes.NewValue(tftypes.String, "abc123"),
+	})
+
+	target := reflect.New(reflect.TypeOf(model{})).Elem()
+
+	result, diags := fwreflect.Struct(context.Background(), objType, object, target, fwreflect.Options{FieldNameConverter: toSnakeFieldNameConverter}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	got := result.Interface().(model)
+	if want := "abc123"; got.UserID != want {
+		t.Errorf("UserID: got %q, want %q", got.UserID, want)
+	}
+}
+
+func TestStruct_fieldNameConverterCollision(t *testing.T) {
+	t.Parallel()
+
+	// UserId and UserID both convert to "user_id" via toSnakeFieldNameConverter,
+	// so this must be diagnosed as a collision just like two identical
+	// tfsdk tags would be.
+	type model struct {
+		UserId string
+		UserID string
+	}
+
+	target := reflect.New(reflect.TypeOf(model{})).Elem()
+	objType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"user_id": types.StringType,
+	}}
+	object := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"user_id": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"user_id": tftypes.NewValue(tftypes.String, "abc123"),
+	})
+
+	_, diags := fwreflect.Struct(context.Background(), objType, object, target, fwreflect.Options{FieldNameConverter: toSnakeFieldNameConverter}, path.Empty())
+	if !diags.HasError() {
+		t.Fatalf("expected a collision diagnostic between two converter-derived names, got none")
+	}
+}
+
+// TestFromStruct_fieldNameConverterNested confirms the chunk0-1 opts
+// propagation fix also resolved this package's other outbound regression:
+// a nested struct relying solely on FieldNameConverter (no tfsdk tags)
+// failed to serialize via FromStruct even though it decoded fine via
+// Struct, since FromValue previously had no way to carry opts down into
+// its recursive FromStruct call.
+func TestFromStruct_fieldNameConverterNested(t *testing.T) {
+	t.Parallel()
+
+	type inner struct {
+		UserID string
+	}
+	type outer struct {
+		Inner inner `tfsdk:"inner"`
+	}
+
+	innerType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"user_id": types.StringType,
+	}}
+	outerType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"inner": innerType,
+	}}
+
+	model := outer{Inner: inner{UserID: "abc123"}}
+
+	val, diags := fwreflect.FromStruct(context.Background(), outerType, reflect.ValueOf(model), fwreflect.Options{FieldNameConverter: toSnakeFieldNameConverter}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	outerObj := val.(types.Object)
+	innerObj := outerObj.Attributes()["inner"].(types.Object)
+
+	if got, want := innerObj.Attributes()["user_id"], types.StringValue("abc123"); !got.Equal(want) {
+		t.Errorf("inner.user_id: got %#v, want %#v", got, want)
+	}
+}
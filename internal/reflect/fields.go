@@ -0,0 +1,215 @@
+package reflect
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unsafe"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// forceSendFieldsFieldName is the name of the sibling field on a target
+// struct that, following the convention used by the Google and
+// Databricks Go SDKs, lists the names of fields whose zero value should
+// be sent as a known value instead of being collapsed to null. It is
+// not itself addressed by a tfsdk tag and is excluded from the 1:1
+// attribute-matching check.
+const forceSendFieldsFieldName = "ForceSendFields"
+
+// structField represents a single Go struct field that has been mapped
+// to a tfsdk attribute name.
+type structField struct {
+	// name is the canonical tfsdk attribute name the field is mapped
+	// to, and the name FromStruct always emits.
+	name string
+
+	// aliases are historical attribute names, read from a
+	// `tfsdk_aliases` tag, that the field also accepts on input so a
+	// provider can rename an attribute across releases without
+	// breaking state read with the old name.
+	aliases []string
+
+	// index is the sequence of field indices needed to reach this
+	// field from the root struct, suitable for passing to
+	// fieldByIndex.
+	index []int
+}
+
+// names returns the canonical name followed by any aliases, the full
+// set of object attribute names this field will accept on input.
+func (f structField) names() []string {
+	return append([]string{f.name}, f.aliases...)
+}
+
+// structFields affords a deterministic view of the tagged fields of a
+// struct, suitable for driving the attribute <-> field conversions in
+// this package.
+type structFields struct {
+	// nameIndex maps a tfsdk attribute name, or one of its aliases,
+	// to its field's position in list.
+	nameIndex map[string]int
+
+	// list is the set of fields on the struct that are addressable
+	// by a tfsdk attribute name, in declaration order.
+	list []structField
+}
+
+// typeFields returns the tfsdk-tagged fields of `typ`, which must be a
+// struct type. Every exported field must carry a `tfsdk` tag, either
+// naming the attribute it maps to or, for fields that aren't part of
+// the object, `tfsdk:"-"`. The ForceSendFields field, if present, is
+// exempted from this requirement, as it is not itself an attribute.
+//
+// Anonymous (embedded) struct fields that carry no `tfsdk` tag of their
+// own have their fields promoted into the returned structFields, the
+// same way encoding/json promotes embedded fields, so a model can be
+// composed out of reusable sub-structs. Promoted fields participate in
+// the same name-collision checks as fields declared directly on `typ`.
+//
+// A field may also carry a `tfsdk_aliases:"old_name1,old_name2"` tag,
+// listing historical attribute names it should also match on input.
+// Aliases participate in the same name-collision checks as canonical
+// names, so two fields can never claim overlapping names.
+//
+// If opts.FieldNameConverter is set, a field missing a `tfsdk` tag is
+// named by passing its Go field name through the converter instead of
+// that omission being an error, letting an entire struct go untagged
+// when a consistent naming convention applies.
+func typeFields(typ reflect.Type, opts Options, path path.Path) (structFields, diag.Diagnostics) {
+	ret := structFields{
+		nameIndex: make(map[string]int),
+	}
+
+	diags := collectFields(typ, nil, opts, path, &ret)
+
+	return ret, diags
+}
+
+// collectFields walks the fields of `typ`, appending them to `ret`.
+// `index` is the index path, if any, used to reach `typ` itself from
+// the struct originally passed to typeFields, and is empty for the
+// top-level call.
+func collectFields(typ reflect.Type, index []int, opts Options, path path.Path, ret *structFields) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		fieldIndex := append(append([]int{}, index...), i)
+
+		// unexported fields can't be set through reflection, so
+		// there's nothing for us to do with them -- except an
+		// anonymous field of an unexported struct type (e.g. an
+		// embedded, package-private shared struct), which may still
+		// promote exported fields of its own, the same way
+		// encoding/json handles this case.
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+
+		tag, tagged := field.Tag.Lookup("tfsdk")
+		if tag == "-" {
+			continue
+		}
+
+		if field.Anonymous && !tagged {
+			embeddedTyp := field.Type
+			if embeddedTyp.Kind() == reflect.Ptr {
+				embeddedTyp = embeddedTyp.Elem()
+			}
+			if embeddedTyp.Kind() == reflect.Struct {
+				diags.Append(collectFields(embeddedTyp, fieldIndex, opts, path, ret)...)
+				continue
+			}
+			if field.PkgPath != "" {
+				// an unexported, non-struct embedded field (e.g. an
+				// embedded named primitive type) has nothing to
+				// promote
+				continue
+			}
+		}
+
+		if !tagged && field.Name == forceSendFieldsFieldName {
+			if field.Type != reflect.TypeOf([]string(nil)) {
+				diags.AddAttributeError(path, "Invalid ForceSendFields Field",
+					fmt.Sprintf("The %s field on %s must be of type []string, got %s.", forceSendFieldsFieldName, typ, field.Type))
+			}
+			continue
+		}
+
+		if !tagged {
+			if opts.FieldNameConverter == nil {
+				diags.AddAttributeError(path, "Field Missing tfsdk Tag",
+					fmt.Sprintf("Field %s in struct %s is missing a tfsdk tag. "+
+						"Every field in the struct must be tagged to indicate the attribute it maps to, "+
+						"or be tagged tfsdk:\"-\" to explicitly opt out of being part of the object.", field.Name, typ))
+				continue
+			}
+			tag = opts.FieldNameConverter(field.Name)
+		}
+
+		var aliases []string
+		if aliasTag, ok := field.Tag.Lookup("tfsdk_aliases"); ok && aliasTag != "" {
+			aliases = strings.Split(aliasTag, ",")
+		}
+
+		sf := structField{
+			name:    tag,
+			aliases: aliases,
+			index:   fieldIndex,
+		}
+
+		var collision bool
+		for _, name := range sf.names() {
+			if _, ok := ret.nameIndex[name]; ok {
+				diags.AddAttributeError(path, "Duplicate tfsdk Name",
+					fmt.Sprintf("Field %s in struct %s uses the name or alias %q, which is already used by another field or promoted field in the same struct.", field.Name, typ, name))
+				collision = true
+			}
+		}
+		if collision {
+			continue
+		}
+
+		for _, name := range sf.names() {
+			ret.nameIndex[name] = len(ret.list)
+		}
+		ret.list = append(ret.list, sf)
+	}
+
+	return diags
+}
+
+// fieldByIndex returns the struct field addressed by index, descending
+// into nested fields as needed. It mirrors reflect.Value.FieldByIndex,
+// but allocates intermediate nil pointers instead of panicking on
+// them. When v is not addressable, so there is nowhere to store an
+// allocation, a nil intermediate pointer yields the zero value of the
+// addressed field instead.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanAddr() {
+					return reflect.Zero(v.Type().Elem().FieldByIndex(index[i:]).Type)
+				}
+				if !v.CanSet() {
+					// an embedded pointer to an unexported struct
+					// type can only be set through its address
+					v = reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// commaSeparatedString joins a slice of strings for inclusion in error
+// messages.
+func commaSeparatedString(items []string) string {
+	return strings.Join(items, ", ")
+}
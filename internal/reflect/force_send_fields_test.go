@@ -0,0 +1,155 @@
+package reflect_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	fwreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+type forceSendFieldsModel struct {
+	Name            string `tfsdk:"name"`
+	Count           int64  `tfsdk:"count"`
+	ForceSendFields []string
+}
+
+func forceSendFieldsObjectType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":  types.StringType,
+		"count": types.Int64Type,
+	}}
+}
+
+func TestFromStruct_forceSendFields(t *testing.T) {
+	t.Parallel()
+
+	model := forceSendFieldsModel{
+		Name:            "",
+		Count:           0,
+		ForceSendFields: []string{"name"},
+	}
+
+	val, diags := fwreflect.FromStruct(context.Background(), forceSendFieldsObjectType().(attr.TypeWithAttributeTypes), reflect.ValueOf(model), fwreflect.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	obj, ok := val.(types.Object)
+	if !ok {
+		t.Fatalf("expected types.Object, got %T", val)
+	}
+	attrs := obj.Attributes()
+
+	if got, want := attrs["name"], types.StringValue(""); !got.Equal(want) {
+		t.Errorf("name: got %#v, want %#v (forced zero value, not null)", got, want)
+	}
+	if got, want := attrs["count"], types.Int64Null(); !got.Equal(want) {
+		t.Errorf("count: got %#v, want %#v (not in ForceSendFields, should be null)", got, want)
+	}
+}
+
+func TestFromStruct_disableForceSendFields(t *testing.T) {
+	t.Parallel()
+
+	model := forceSendFieldsModel{
+		Name:            "",
+		Count:           0,
+		ForceSendFields: []string{"name"},
+	}
+
+	val, diags := fwreflect.FromStruct(context.Background(), forceSendFieldsObjectType().(attr.TypeWithAttributeTypes), reflect.ValueOf(model), fwreflect.Options{DisableForceSendFields: true}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	obj, ok := val.(types.Object)
+	if !ok {
+		t.Fatalf("expected types.Object, got %T", val)
+	}
+	attrs := obj.Attributes()
+
+	// with the feature disabled, every zero value serializes as its
+	// concrete zero value, same as before ForceSendFields existed.
+	if got, want := attrs["name"], types.StringValue(""); !got.Equal(want) {
+		t.Errorf("name: got %#v, want %#v", got, want)
+	}
+	if got, want := attrs["count"], types.Int64Value(0); !got.Equal(want) {
+		t.Errorf("count: got %#v, want %#v", got, want)
+	}
+}
+
+func TestStruct_forceSendFields(t *testing.T) {
+	t.Parallel()
+
+	object := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":  tftypes.String,
+		"count": tftypes.Number,
+	}}, map[string]tftypes.Value{
+		"name":  tftypes.NewValue(tftypes.String, ""),
+		"count": tftypes.NewValue(tftypes.Number, nil),
+	})
+
+	target := reflect.New(reflect.TypeOf(forceSendFieldsModel{})).Elem()
+
+	result, diags := fwreflect.Struct(context.Background(), forceSendFieldsObjectType(), object, target, fwreflect.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	got := result.Interface().(forceSendFieldsModel)
+	if want := []string{"name"}; !reflect.DeepEqual(got.ForceSendFields, want) {
+		t.Errorf("ForceSendFields: got %v, want %v", got.ForceSendFields, want)
+	}
+}
+
+// TestFromStruct_forceSendFieldsNested guards against opts failing to
+// propagate into the recursive FromValue -> FromStruct call for a
+// nested struct field: with DisableForceSendFields set on the outer
+// call, the inner struct's own ForceSendFields must not be honored
+// either.
+func TestFromStruct_forceSendFieldsNested(t *testing.T) {
+	t.Parallel()
+
+	type outerModel struct {
+		Inner forceSendFieldsModel `tfsdk:"inner"`
+	}
+
+	model := outerModel{
+		Inner: forceSendFieldsModel{
+			Name:            "",
+			Count:           0,
+			ForceSendFields: []string{"name"},
+		},
+	}
+
+	outerType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"inner": forceSendFieldsObjectType(),
+	}}
+
+	val, diags := fwreflect.FromStruct(context.Background(), outerType, reflect.ValueOf(model), fwreflect.Options{DisableForceSendFields: true}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	outerObj := val.(types.Object)
+	innerObj := outerObj.Attributes()["inner"].(types.Object)
+	innerAttrs := innerObj.Attributes()
+
+	// with DisableForceSendFields propagated to the nested struct, its
+	// own ForceSendFields is not consulted and "name" serializes as its
+	// concrete zero value -- the old always-concrete behavior -- rather
+	// than being forced by the inner struct's ForceSendFields slice
+	// acting as if Options{} (the zero value) were in effect.
+	if got, want := innerAttrs["name"], types.StringValue(""); !got.Equal(want) {
+		t.Errorf("inner.name: got %#v, want %#v", got, want)
+	}
+	if got, want := innerAttrs["count"], types.Int64Value(0); !got.Equal(want) {
+		t.Errorf("inner.count: got %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,47 @@
+package reflect
+
+// Options represents options that can be used to tweak reflection
+// behavior for any of the reflect package's exported functions.
+type Options struct {
+	// DisableForceSendFields disables populating and honoring the
+	// ForceSendFields convention on target structs (as used by the
+	// Google and Databricks Go SDKs). When left enabled (the
+	// default), a target struct with a `ForceSendFields []string`
+	// field lets a provider distinguish an attribute that was
+	// explicitly set to its zero value from one that was left
+	// unset: Struct populates ForceSendFields with the names of
+	// attributes that arrived known and non-null but decoded to a
+	// zero Go value, and FromStruct serializes a zero-valued field
+	// as a concrete value instead of null when its name appears in
+	// ForceSendFields. Set this to true to restore the prior behavior
+	// of always serializing a field's concrete zero value, never
+	// collapsing it to null.
+	DisableForceSendFields bool
+
+	// AllowExtraStructFields, when true, downgrades "struct defines
+	// fields not found in object" from an error to a warning
+	// diagnostic in Struct. Fields left unmatched this way are left
+	// at their Go zero value. This makes it possible to add a field
+	// to a struct ahead of the framework schema that will eventually
+	// populate it.
+	AllowExtraStructFields bool
+
+	// AllowExtraObjectAttributes, when true, downgrades "object
+	// defines attributes not found in struct" from an error to a
+	// warning diagnostic in Struct. Attributes left unmatched this
+	// way are discarded. This makes it possible for the framework
+	// schema to expose a new computed attribute without every
+	// provider binary built against an older struct breaking.
+	AllowExtraObjectAttributes bool
+
+	// FieldNameConverter, when set, is used to derive the tfsdk
+	// attribute name of a field that carries no `tfsdk` tag, instead
+	// of that omission being treated as an error. This lets an entire
+	// struct go untagged when a consistent naming convention applies,
+	// e.g. strcase.ToSnake to turn UserID into user_id. A field may
+	// still use an explicit `tfsdk` tag to override the converter, or
+	// `tfsdk:"-"` to opt out of being part of the object entirely. The
+	// default, nil, preserves the prior behavior of requiring an
+	// explicit tfsdk tag on every field.
+	FieldNameConverter func(goFieldName string) string
+}
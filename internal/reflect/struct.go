@@ -23,7 +23,22 @@ import (
 // attributes in the type of `object` must have a corresponding property.
 // Properties that don't map to object attributes must have a `tfsdk:"-"` tag,
 // explicitly defining them as not part of the object. This is to catch typos
-// and other mistakes early.
+// and other mistakes early. Fields promoted from an untagged, anonymous
+// embedded struct field are addressed by their own tfsdk tag, exactly as if
+// they were declared directly on `target`.
+//
+// If `target` has a `ForceSendFields []string` field, it is populated with
+// the names of attributes that arrived known and non-null but decoded to a
+// zero Go value, so a later FromStruct call can tell the difference between
+// an explicit zero value and an absent one. See Options.DisableForceSendFields
+// to opt out.
+//
+// A field's attribute may also go by any of its tfsdk_aliases, letting a
+// struct accept a historical attribute name during schema evolution; see
+// typeFields. Options.AllowExtraStructFields and
+// Options.AllowExtraObjectAttributes relax the 1:1 requirement itself,
+// downgrading an unmatched field or attribute to a warning instead of an
+// error.
 //
 // Struct is meant to be called from Into, not directly.
 func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
@@ -71,22 +86,46 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 
 	// collect a map of fields that are defined in the tags of the struct
 	// passed in
-	targetFields := typeFields(target.Type())
+	targetFields, fieldDiags := typeFields(target.Type(), opts, path)
+	diags.Append(fieldDiags...)
+	if diags.HasError() {
+		return target, diags
+	}
 
-	// we require an exact, 1:1 match of these fields to avoid typos
-	// leading to surprises, so let's ensure they have the exact same
-	// fields defined
+	// we require a 1:1 match of these fields to avoid typos leading to
+	// surprises, so let's ensure they have the same fields defined,
+	// matching a field against an object attribute that shares either
+	// its canonical tfsdk name or one of its tfsdk_aliases
 	var objectMissing, targetMissing []string
-	for field := range targetFields.nameIndex {
-		if _, ok := objectFields[field]; !ok {
-			objectMissing = append(objectMissing, field)
+	objectNameByField := make(map[string]string, len(targetFields.list))
+	for _, field := range targetFields.list {
+		name, ok := presentObjectAttribute(field, objectFields)
+		if !ok {
+			objectMissing = append(objectMissing, field.name)
+			continue
 		}
+		objectNameByField[field.name] = name
 	}
 	for field := range objectFields {
 		if _, ok := targetFields.nameIndex[field]; !ok {
 			targetMissing = append(targetMissing, field)
 		}
 	}
+
+	if len(objectMissing) > 0 {
+		if opts.AllowExtraStructFields {
+			diags.AddAttributeWarning(path, "Struct Defines Extra Fields",
+				fmt.Sprintf("Struct defines fields not found in object, which will be left at their zero value: %s.", commaSeparatedString(objectMissing)))
+			objectMissing = nil
+		}
+	}
+	if len(targetMissing) > 0 {
+		if opts.AllowExtraObjectAttributes {
+			diags.AddAttributeWarning(path, "Object Defines Extra Attributes",
+				fmt.Sprintf("Object defines attributes not found in struct, which will be discarded: %s.", commaSeparatedString(targetMissing)))
+			targetMissing = nil
+		}
+	}
 	if len(objectMissing) > 0 || len(targetMissing) > 0 {
 		var missing []string
 		if len(objectMissing) > 0 {
@@ -105,11 +144,18 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 
 	attrTypes := attrsType.AttributeTypes()
 
-	// now that we know they match perfectly, fill the struct with the
-	// values in the object
+	// now that we know they match, fill the struct with the values in
+	// the object
 	result := reflect.New(target.Type()).Elem()
 	for _, field := range targetFields.list {
-		attrType, ok := attrTypes[field.name]
+		objectName, ok := objectNameByField[field.name]
+		if !ok {
+			// an extra struct field allowed through by
+			// opts.AllowExtraStructFields; leave it zero-valued
+			continue
+		}
+
+		attrType, ok := attrTypes[objectName]
 		if !ok {
 			diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
 				Val:        object,
@@ -120,7 +166,7 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 		}
 
 		structField := fieldByIndex(result, field.index)
-		fieldVal, fieldValDiags := BuildValue(ctx, attrType, objectFields[field.name], structField, opts, path.AtName(field.name))
+		fieldVal, fieldValDiags := BuildValue(ctx, attrType, objectFields[objectName], structField, opts, path.AtName(objectName))
 		diags.Append(fieldValDiags...)
 
 		if diags.HasError() {
@@ -128,37 +174,88 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 		}
 		structField.Set(fieldVal)
 	}
+
+	if !opts.DisableForceSendFields {
+		if forceSendFields := result.FieldByName(forceSendFieldsFieldName); forceSendFields.IsValid() && forceSendFields.Type() == reflect.TypeOf([]string(nil)) {
+			var sent []string
+			for _, field := range targetFields.list {
+				objectName, ok := objectNameByField[field.name]
+				if !ok {
+					continue
+				}
+				objVal := objectFields[objectName]
+				if !objVal.IsKnown() || objVal.IsNull() {
+					continue
+				}
+				if fieldByIndex(result, field.index).IsZero() {
+					sent = append(sent, field.name)
+				}
+			}
+			forceSendFields.Set(reflect.ValueOf(sent))
+		}
+	}
+
 	return result, diags
 }
 
+// presentObjectAttribute returns the name under which `field` actually
+// appears in `objectFields`, which may be its canonical tfsdk name or
+// one of its tfsdk_aliases. The canonical name is preferred when both
+// happen to be present.
+func presentObjectAttribute(field structField, objectFields map[string]tftypes.Value) (string, bool) {
+	for _, name := range field.names() {
+		if _, ok := objectFields[name]; ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
 // FromStruct builds an attr.Value as produced by `typ` from the data in `val`.
 // `val` must be a struct type, and must have all its properties tagged and be
 // a 1:1 match with the attributes reported by `typ`. FromStruct will recurse
 // into FromValue for each attribute, using the type of the attribute as
-// reported by `typ`.
+// reported by `typ`, passing `opts` through so that a nested struct field
+// sees the same Options as `val` did.
+//
+// If `val` has a valid `ForceSendFields []string` field, a zero-valued
+// field is serialized as a concrete, known value only when its name
+// appears in ForceSendFields; otherwise zero-valued fields are
+// serialized as null. A struct with no such field is unaffected and
+// always serializes its fields' concrete values, same as before
+// ForceSendFields existed. See Options.DisableForceSendFields to opt a
+// struct that does have the field out of this behavior.
 //
 // It is meant to be called through FromValue, not directly.
-func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflect.Value, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	objTypes := map[string]tftypes.Type{}
 	objValues := map[string]tftypes.Value{}
 
 	// collect a map of fields that are defined in the tags of the struct
 	// passed in
-	valFields := typeFields(val.Type())
+	valFields, fieldDiags := typeFields(val.Type(), opts, path)
+	diags.Append(fieldDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	hasForceSendFields := false
+	forceSendFields := make(map[string]bool)
+	if !opts.DisableForceSendFields {
+		if fsField := val.FieldByName(forceSendFieldsFieldName); fsField.IsValid() && fsField.Type() == reflect.TypeOf([]string(nil)) {
+			hasForceSendFields = true
+			for _, name := range fsField.Interface().([]string) {
+				forceSendFields[name] = true
+			}
+		}
+	}
 
 	attrTypes := typ.AttributeTypes()
 	for _, field := range valFields.list {
 		path := path.AtName(field.name)
 		fieldValue := fieldByIndex(val, field.index)
 
-		attrVal, attrValDiags := FromValue(ctx, attrTypes[field.name], fieldValue.Interface(), path)
-		diags.Append(attrValDiags...)
-
-		if diags.HasError() {
-			return nil, diags
-		}
-
 		attrType, ok := attrTypes[field.name]
 		if !ok || attrType == nil {
 			err := fmt.Errorf("couldn't find type information for attribute at %s in supplied attr.Type %T", path, typ)
@@ -172,6 +269,18 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 
 		objTypes[field.name] = attrType.TerraformType(ctx)
 
+		if hasForceSendFields && fieldValue.IsZero() && !forceSendFields[field.name] {
+			objValues[field.name] = tftypes.NewValue(attrType.TerraformType(ctx), nil)
+			continue
+		}
+
+		attrVal, attrValDiags := FromValue(ctx, attrType, fieldValue.Interface(), opts, path)
+		diags.Append(attrValDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
 		tfObjVal, err := attrVal.ToTerraformValue(ctx)
 		if err != nil {
 			return nil, append(diags, toTerraformValueErrorDiag(err, path))
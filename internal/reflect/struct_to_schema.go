@@ -0,0 +1,316 @@
+package reflect
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StructToSchema derives a resource schema.Schema from a Go struct whose
+// fields carry the tfsdk tags that Into and FromValue already consume,
+// so a provider doesn't have to hand-maintain a schema declaration that
+// exactly mirrors the struct it decodes into. `target` may be a struct
+// or a pointer to one.
+//
+// A nested struct field produces a SingleNestedAttribute. A slice or map
+// of structs produces a ListNestedAttribute or MapNestedAttribute, keyed
+// the same way the struct itself is walked, including fields promoted
+// from anonymous embedded structs (see typeFields). A field already
+// expressed as one of the scalar types in the types package (types.String,
+// types.Bool, types.Int64, types.Float64, types.Number) maps to the
+// matching schema attribute; StructToSchema has no way to recover the
+// element type of a types.List, types.Set, or types.Map field, so those
+// should be expressed as a Go slice/map of the element's Go type instead.
+//
+// Alongside `tfsdk`, fields may carry a `tf` tag to set the flags a
+// struct alone can't express, e.g. `tf:"required"`, `tf:"computed,sensitive"`,
+// or `tf:"plan_modifier=use_state_for_unknown"`. customizer, when
+// non-nil, is called with every attribute's path and its derived
+// schema.Attribute and its return value is used in its place -- this is
+// the escape hatch for validators, defaults, and plan modifiers that
+// StructToSchema has no way to infer on its own.
+//
+// opts is the same Options passed to Struct/FromStruct for `target`,
+// most notably FieldNameConverter: a struct that relies on it to go
+// without tfsdk tags needs the identical Options here, or typeFields
+// will report the same missing-tag error StructToSchema reads the
+// struct's tags with.
+func StructToSchema(target any, opts Options, customizer func(path.Path, schema.Attribute) schema.Attribute) schema.Schema {
+	targetType := reflect.TypeOf(target)
+	for targetType.Kind() == reflect.Ptr {
+		targetType = targetType.Elem()
+	}
+
+	return schema.Schema{
+		Attributes: attributesForStruct(targetType, opts, path.Empty(), customizer),
+	}
+}
+
+// attributesForStruct walks the tfsdk-tagged fields of `typ`, deriving a
+// schema.Attribute for each and returning them keyed by attribute name.
+func attributesForStruct(typ reflect.Type, opts Options, p path.Path, customizer func(path.Path, schema.Attribute) schema.Attribute) map[string]schema.Attribute {
+	fields, diags := typeFields(typ, opts, p)
+	if diags.HasError() {
+		panic(fmt.Sprintf("reflect.StructToSchema: %s is not a valid schema source: %s", typ, diags))
+	}
+
+	attributes := make(map[string]schema.Attribute, len(fields.list))
+	for _, field := range fields.list {
+		fieldPath := p.AtName(field.name)
+		structField := typ.FieldByIndex(field.index)
+
+		attribute := attributeForGoType(structField.Type, opts, parseTFTag(structField.Tag.Get("tf")), fieldPath, customizer)
+		if customizer != nil {
+			attribute = customizer(fieldPath, attribute)
+		}
+
+		attributes[field.name] = attribute
+	}
+
+	return attributes
+}
+
+// tfTagOptions are the flags StructToSchema reads off of a field's `tf`
+// struct tag, a comma-separated list of keywords alongside the tfsdk
+// tag that fills in what a Go struct alone can't express about an
+// attribute.
+type tfTagOptions struct {
+	required     bool
+	optional     bool
+	computed     bool
+	sensitive    bool
+	planModifier string
+}
+
+func parseTFTag(tag string) tfTagOptions {
+	var opts tfTagOptions
+	for _, part := range strings.Split(tag, ",") {
+		switch {
+		case part == "required":
+			opts.required = true
+		case part == "optional":
+			opts.optional = true
+		case part == "computed":
+			opts.computed = true
+		case part == "sensitive":
+			opts.sensitive = true
+		case strings.HasPrefix(part, "plan_modifier="):
+			opts.planModifier = strings.TrimPrefix(part, "plan_modifier=")
+		}
+	}
+	// Optional is the schema.Attribute default; a field that specifies
+	// neither is left optional, same as leaving the tf tag off entirely.
+	if !opts.required && !opts.computed {
+		opts.optional = true
+	}
+	return opts
+}
+
+var (
+	stringValueType  = reflect.TypeOf(types.String{})
+	boolValueType    = reflect.TypeOf(types.Bool{})
+	int64ValueType   = reflect.TypeOf(types.Int64{})
+	float64ValueType = reflect.TypeOf(types.Float64{})
+	numberValueType  = reflect.TypeOf(types.Number{})
+)
+
+// attributeForGoType derives the schema.Attribute that corresponds to a
+// single Go field's type. opts is threaded through to any recursive
+// attributesForStruct call for a nested struct, slice-of-struct, or
+// map-of-struct field, and tfOpts carries the flags read off that
+// field's own `tf` tag.
+func attributeForGoType(goType reflect.Type, opts Options, tfOpts tfTagOptions, p path.Path, customizer func(path.Path, schema.Attribute) schema.Attribute) schema.Attribute {
+	for goType.Kind() == reflect.Ptr {
+		goType = goType.Elem()
+	}
+
+	switch {
+	case goType == stringValueType, goType.Kind() == reflect.String:
+		return schema.StringAttribute{
+			Required:      tfOpts.required,
+			Optional:      tfOpts.optional,
+			Computed:      tfOpts.computed,
+			Sensitive:     tfOpts.sensitive,
+			PlanModifiers: stringPlanModifiers(tfOpts.planModifier),
+		}
+	case goType == boolValueType, goType.Kind() == reflect.Bool:
+		return schema.BoolAttribute{
+			Required:      tfOpts.required,
+			Optional:      tfOpts.optional,
+			Computed:      tfOpts.computed,
+			Sensitive:     tfOpts.sensitive,
+			PlanModifiers: boolPlanModifiers(tfOpts.planModifier),
+		}
+	case goType == int64ValueType, goType.Kind() == reflect.Int, goType.Kind() == reflect.Int64:
+		return schema.Int64Attribute{
+			Required:      tfOpts.required,
+			Optional:      tfOpts.optional,
+			Computed:      tfOpts.computed,
+			Sensitive:     tfOpts.sensitive,
+			PlanModifiers: int64PlanModifiers(tfOpts.planModifier),
+		}
+	case goType == float64ValueType, goType == numberValueType, goType.Kind() == reflect.Float64:
+		return schema.Float64Attribute{
+			Required:      tfOpts.required,
+			Optional:      tfOpts.optional,
+			Computed:      tfOpts.computed,
+			Sensitive:     tfOpts.sensitive,
+			PlanModifiers: float64PlanModifiers(tfOpts.planModifier),
+		}
+	case goType.Kind() == reflect.Struct:
+		return schema.SingleNestedAttribute{
+			Attributes: attributesForStruct(goType, opts, p, customizer),
+			Required:   tfOpts.required,
+			Optional:   tfOpts.optional,
+			Computed:   tfOpts.computed,
+			Sensitive:  tfOpts.sensitive,
+		}
+	case goType.Kind() == reflect.Slice:
+		elemType := goType.Elem()
+		for elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		if elemType.Kind() == reflect.Struct {
+			return schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attributesForStruct(elemType, opts, p, customizer),
+				},
+				Required:      tfOpts.required,
+				Optional:      tfOpts.optional,
+				Computed:      tfOpts.computed,
+				Sensitive:     tfOpts.sensitive,
+				PlanModifiers: listPlanModifiers(tfOpts.planModifier),
+			}
+		}
+		return schema.ListAttribute{
+			ElementType:   attrTypeForGoType(elemType),
+			Required:      tfOpts.required,
+			Optional:      tfOpts.optional,
+			Computed:      tfOpts.computed,
+			Sensitive:     tfOpts.sensitive,
+			PlanModifiers: listPlanModifiers(tfOpts.planModifier),
+		}
+	case goType.Kind() == reflect.Map:
+		elemType := goType.Elem()
+		for elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		if elemType.Kind() == reflect.Struct {
+			return schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attributesForStruct(elemType, opts, p, customizer),
+				},
+				Required:      tfOpts.required,
+				Optional:      tfOpts.optional,
+				Computed:      tfOpts.computed,
+				Sensitive:     tfOpts.sensitive,
+				PlanModifiers: mapPlanModifiers(tfOpts.planModifier),
+			}
+		}
+		return schema.MapAttribute{
+			ElementType:   attrTypeForGoType(elemType),
+			Required:      tfOpts.required,
+			Optional:      tfOpts.optional,
+			Computed:      tfOpts.computed,
+			Sensitive:     tfOpts.sensitive,
+			PlanModifiers: mapPlanModifiers(tfOpts.planModifier),
+		}
+	default:
+		panic(fmt.Sprintf("reflect.StructToSchema: no attribute mapping for Go type %s", goType))
+	}
+}
+
+// attrTypeForGoType returns the attr.Type used as the ElementType of a
+// ListAttribute or MapAttribute whose elements are the given Go type.
+func attrTypeForGoType(goType reflect.Type) attr.Type {
+	switch {
+	case goType == stringValueType, goType.Kind() == reflect.String:
+		return types.StringType
+	case goType == boolValueType, goType.Kind() == reflect.Bool:
+		return types.BoolType
+	case goType == int64ValueType, goType.Kind() == reflect.Int, goType.Kind() == reflect.Int64:
+		return types.Int64Type
+	case goType == float64ValueType, goType == numberValueType, goType.Kind() == reflect.Float64:
+		return types.Float64Type
+	default:
+		panic(fmt.Sprintf("reflect.StructToSchema: no attr.Type mapping for Go element type %s", goType))
+	}
+}
+
+func stringPlanModifiers(name string) []planmodifier.String {
+	switch name {
+	case "":
+		return nil
+	case "use_state_for_unknown":
+		return []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+	default:
+		panic(fmt.Sprintf("reflect.StructToSchema: unknown plan_modifier %q for a string attribute", name))
+	}
+}
+
+func boolPlanModifiers(name string) []planmodifier.Bool {
+	switch name {
+	case "":
+		return nil
+	case "use_state_for_unknown":
+		return []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}
+	default:
+		panic(fmt.Sprintf("reflect.StructToSchema: unknown plan_modifier %q for a bool attribute", name))
+	}
+}
+
+func int64PlanModifiers(name string) []planmodifier.Int64 {
+	switch name {
+	case "":
+		return nil
+	case "use_state_for_unknown":
+		return []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}
+	default:
+		panic(fmt.Sprintf("reflect.StructToSchema: unknown plan_modifier %q for an int64 attribute", name))
+	}
+}
+
+func float64PlanModifiers(name string) []planmodifier.Float64 {
+	switch name {
+	case "":
+		return nil
+	case "use_state_for_unknown":
+		return []planmodifier.Float64{float64planmodifier.UseStateForUnknown()}
+	default:
+		panic(fmt.Sprintf("reflect.StructToSchema: unknown plan_modifier %q for a float64 attribute", name))
+	}
+}
+
+func listPlanModifiers(name string) []planmodifier.List {
+	switch name {
+	case "":
+		return nil
+	case "use_state_for_unknown":
+		return []planmodifier.List{listplanmodifier.UseStateForUnknown()}
+	default:
+		panic(fmt.Sprintf("reflect.StructToSchema: unknown plan_modifier %q for a list attribute", name))
+	}
+}
+
+func mapPlanModifiers(name string) []planmodifier.Map {
+	switch name {
+	case "":
+		return nil
+	case "use_state_for_unknown":
+		return []planmodifier.Map{mapplanmodifier.UseStateForUnknown()}
+	default:
+		panic(fmt.Sprintf("reflect.StructToSchema: unknown plan_modifier %q for a map attribute", name))
+	}
+}
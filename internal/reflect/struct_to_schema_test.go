@@ -0,0 +1,181 @@
+package reflect_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	fwreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+type structToSchemaModel struct {
+	Name  string `tfsdk:"name" tf:"required"`
+	Count int64  `tfsdk:"count" tf:"computed,plan_modifier=use_state_for_unknown"`
+	Inner struct {
+		ID string `tfsdk:"id"`
+	} `tfsdk:"inner"`
+	Tags    []string             `tfsdk:"tags"`
+	Widgets []structToSchemaElem `tfsdk:"widgets"`
+	Labels  map[string]string    `tfsdk:"labels"`
+}
+
+type structToSchemaElem struct {
+	Name string `tfsdk:"name"`
+}
+
+func TestStructToSchema_scalarsAndNesting(t *testing.T) {
+	t.Parallel()
+
+	s := fwreflect.StructToSchema(structToSchemaModel{}, fwreflect.Options{}, nil)
+
+	nameAttr, ok := s.Attributes["name"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("name: got %T, want schema.StringAttribute", s.Attributes["name"])
+	}
+	if !nameAttr.Required {
+		t.Errorf("name: expected Required from the tf tag")
+	}
+
+	countAttr, ok := s.Attributes["count"].(schema.Int64Attribute)
+	if !ok {
+		t.Fatalf("count: got %T, want schema.Int64Attribute", s.Attributes["count"])
+	}
+	if !countAttr.Computed {
+		t.Errorf("count: expected Computed from the tf tag")
+	}
+	if len(countAttr.PlanModifiers) != 1 {
+		t.Fatalf("count: expected one plan modifier from plan_modifier=use_state_for_unknown, got %d", len(countAttr.PlanModifiers))
+	}
+
+	innerAttr, ok := s.Attributes["inner"].(schema.SingleNestedAttribute)
+	if !ok {
+		t.Fatalf("inner: got %T, want schema.SingleNestedAttribute", s.Attributes["inner"])
+	}
+	if _, ok := innerAttr.Attributes["id"].(schema.StringAttribute); !ok {
+		t.Errorf("inner.id: got %T, want schema.StringAttribute", innerAttr.Attributes["id"])
+	}
+
+	tagsAttr, ok := s.Attributes["tags"].(schema.ListAttribute)
+	if !ok {
+		t.Fatalf("tags: got %T, want schema.ListAttribute", s.Attributes["tags"])
+	}
+	if tagsAttr.ElementType == nil {
+		t.Errorf("tags: expected a non-nil ElementType")
+	}
+
+	widgetsAttr, ok := s.Attributes["widgets"].(schema.ListNestedAttribute)
+	if !ok {
+		t.Fatalf("widgets: got %T, want schema.ListNestedAttribute", s.Attributes["widgets"])
+	}
+	if _, ok := widgetsAttr.NestedObject.Attributes["name"].(schema.StringAttribute); !ok {
+		t.Errorf("widgets[].name: got %T, want schema.StringAttribute", widgetsAttr.NestedObject.Attributes["name"])
+	}
+
+	if _, ok := s.Attributes["labels"].(schema.MapAttribute); !ok {
+		t.Errorf("labels: got %T, want schema.MapAttribute", s.Attributes["labels"])
+	}
+}
+
+func TestStructToSchema_mapOfStruct(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Widgets map[string]structToSchemaElem `tfsdk:"widgets"`
+	}
+
+	s := fwreflect.StructToSchema(model{}, fwreflect.Options{}, nil)
+
+	widgetsAttr, ok := s.Attributes["widgets"].(schema.MapNestedAttribute)
+	if !ok {
+		t.Fatalf("widgets: got %T, want schema.MapNestedAttribute", s.Attributes["widgets"])
+	}
+	if _, ok := widgetsAttr.NestedObject.Attributes["name"].(schema.StringAttribute); !ok {
+		t.Errorf("widgets{}.name: got %T, want schema.StringAttribute", widgetsAttr.NestedObject.Attributes["name"])
+	}
+}
+
+func TestStructToSchema_customizer(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Name string `tfsdk:"name"`
+	}
+
+	s := fwreflect.StructToSchema(model{}, fwreflect.Options{}, func(p path.Path, a schema.Attribute) schema.Attribute {
+		if p.String() == "name" {
+			return schema.StringAttribute{Required: true, Sensitive: true}
+		}
+		return a
+	})
+
+	nameAttr, ok := s.Attributes["name"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("name: got %T, want schema.StringAttribute", s.Attributes["name"])
+	}
+	if !nameAttr.Sensitive {
+		t.Errorf("name: expected the customizer's override to take effect")
+	}
+}
+
+func TestStructToSchema_panicsOnUnrecognizedType(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Value complex128 `tfsdk:"value"`
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatalf("expected a panic for an unrecognized Go type")
+		}
+		if !strings.Contains(fmt.Sprint(r), "no attribute mapping") {
+			t.Errorf("panic message %q does not mention the missing mapping", r)
+		}
+	}()
+
+	fwreflect.StructToSchema(model{}, fwreflect.Options{}, nil)
+}
+
+func TestStructToSchema_panicsOnMissingTfsdkTag(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Name string
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected a panic when a field is missing its tfsdk tag")
+		}
+	}()
+
+	fwreflect.StructToSchema(model{}, fwreflect.Options{}, nil)
+}
+
+func TestStructToSchema_fieldNameConverter(t *testing.T) {
+	t.Parallel()
+
+	// a struct that relies entirely on Options.FieldNameConverter, with
+	// no tfsdk tags at all -- this is the case the chunk0-3 review
+	// comment flagged as broken, since StructToSchema previously
+	// hardcoded Options{} when reading the struct's fields.
+	type model struct {
+		UserID string
+	}
+
+	opts := fwreflect.Options{
+		FieldNameConverter: func(goFieldName string) string {
+			return strings.ToLower(goFieldName)
+		},
+	}
+
+	s := fwreflect.StructToSchema(model{}, opts, nil)
+
+	if _, ok := s.Attributes["userid"].(schema.StringAttribute); !ok {
+		t.Fatalf("userid: got %#v, want schema.StringAttribute derived via FieldNameConverter", s.Attributes["userid"])
+	}
+}
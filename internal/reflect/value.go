@@ -0,0 +1,86 @@
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// FromValue builds an attr.Value of the type described by `typ` from a Go
+// value. A value that is already an attr.Value is returned as-is. A
+// struct value recurses into FromStruct, threading `opts` through so
+// that ForceSendFields, FieldNameConverter, and the rest of Options
+// apply the same way to a nested struct as they did to the struct
+// FromStruct was originally called with. A nil pointer becomes a null
+// value of `typ`; any other pointer is dereferenced. Anything else is
+// treated as a primitive Go value and routed through `typ` by way of
+// its Terraform type.
+//
+// FromValue is meant to be called from FromStruct, not directly.
+func FromValue(ctx context.Context, typ attr.Type, val any, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if attrVal, ok := val.(attr.Value); ok {
+		return attrVal, diags
+	}
+
+	v := reflect.ValueOf(val)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			attrVal, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+			if err != nil {
+				return nil, append(diags, valueFromTerraformErrorDiag(err, path))
+			}
+			return attrVal, diags
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Struct {
+		attrsType, ok := typ.(attr.TypeWithAttributeTypes)
+		if !ok {
+			diags.AddAttributeError(path, "Value Conversion Error",
+				fmt.Sprintf("cannot convert a struct to an attr.Value using type information from %T, %T must be an attr.TypeWithAttributeTypes", typ, typ))
+			return nil, diags
+		}
+		return FromStruct(ctx, attrsType, v, opts, path)
+	}
+
+	tfVal, err := primitiveTerraformValue(typ.TerraformType(ctx), v)
+	if err != nil {
+		diags.AddAttributeError(path, "Value Conversion Error",
+			fmt.Sprintf("could not convert %s to a Terraform value for %s: %s", v.Type(), typ, err))
+		return nil, diags
+	}
+
+	attrVal, err := typ.ValueFromTerraform(ctx, tfVal)
+	if err != nil {
+		return nil, append(diags, valueFromTerraformErrorDiag(err, path))
+	}
+
+	return attrVal, diags
+}
+
+// primitiveTerraformValue converts a Go primitive into a tftypes.Value
+// of tfType, the only part of building an attr.Value FromValue can't
+// delegate to typ.ValueFromTerraform.
+func primitiveTerraformValue(tfType tftypes.Type, v reflect.Value) (tftypes.Value, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return tftypes.NewValue(tfType, v.String()), nil
+	case reflect.Bool:
+		return tftypes.NewValue(tfType, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return tftypes.NewValue(tfType, new(big.Float).SetInt64(v.Int())), nil
+	case reflect.Float32, reflect.Float64:
+		return tftypes.NewValue(tfType, big.NewFloat(v.Float())), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("no Terraform value mapping for Go kind %s", v.Kind())
+	}
+}